@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"container/list"
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -13,17 +15,53 @@ var ErrNotFound = errors.New("item has not been found in the cache")
 type Cache[TKey comparable, TValue any] struct {
 	mtx              sync.RWMutex
 	umtx             sync.RWMutex
-	items            map[TKey]cacheItem[TValue]
+	items            map[TKey]*cacheItem[TKey, TValue]
+	order            *list.List
 	ttl              time.Duration
-	addedFunc        AddedFunc[TKey, TValue]
-	loaderExpireFunc LoaderExpireFunc[TKey, TValue]
+	capacity         int
+	subs             subscribers[TKey, TValue]
+	loaderExpireFunc LoaderExpireFuncCtx[TKey, TValue]
 	loadGroup        Group[TKey, TValue]
+
+	janitorEnabled bool
+	expHeap        expirationHeap[TKey]
+	expIndex       map[TKey]*expirationItem[TKey]
+	timerCh        chan time.Duration
+	stopCh         chan struct{}
+	janitorWG      sync.WaitGroup
+
+	counters counters
 }
 
 // New returns reference to typed  in-memory cache instance
 func New[TKey comparable, TValue any](exp time.Duration) *Cache[TKey, TValue] {
+	return newCache(WithTTL[TKey, TValue](exp))
+}
+
+// NewWithCapacity returns reference to typed in-memory cache instance bounded
+// to capacity entries. Once the cache holds more than capacity items, Set
+// evicts the least recently used one. A capacity <= 0 means unbounded,
+// same as New.
+func NewWithCapacity[TKey comparable, TValue any](exp time.Duration, capacity int) *Cache[TKey, TValue] {
+	return newCache(WithTTL[TKey, TValue](exp), WithCapacity[TKey, TValue](capacity))
+}
+
+// NewWithOptions returns a reference to a typed in-memory cache instance
+// configured by the given options, e.g. WithTTL, WithCapacity and
+// WithJanitor.
+func NewWithOptions[TKey comparable, TValue any](opts ...Option[TKey, TValue]) *Cache[TKey, TValue] {
+	return newCache(opts...)
+}
+
+func newCache[TKey comparable, TValue any](opts ...Option[TKey, TValue]) *Cache[TKey, TValue] {
 	cc := &Cache[TKey, TValue]{}
-	cc.init(exp)
+	for _, opt := range opts {
+		opt(cc)
+	}
+	cc.init()
+	if cc.janitorEnabled {
+		cc.startJanitor()
+	}
 	return cc
 }
 
@@ -65,15 +103,25 @@ func (c *Cache[TKey, TValue]) UpdateWithExpire(key TKey, calc func(v TValue) TVa
 // If it does not exists key and has LoaderFunc,
 // generate a value using `LoaderFunc` method returns value.
 func (c *Cache[TKey, TValue]) Get(key TKey) (TValue, error) {
+	return c.GetContext(context.Background(), key)
+}
+
+// GetContext is like Get, but ctx is passed to a LoaderFuncCtx/LoaderExpireFuncCtx
+// so a slow loader can be cancelled. If ctx is cancelled while other callers
+// are still waiting on the same key, the loader keeps running for them; see
+// Group.Do.
+func (c *Cache[TKey, TValue]) GetContext(ctx context.Context, key TKey) (TValue, error) {
 	v, err := c.get(key)
 	if err == ErrNotFound {
-		return c.getWithLoader(key, true)
+		return c.getWithLoader(ctx, key, true)
 	}
 	return v, err
 }
 
-// Has checks if key exists in cache
+// Has checks if key exists in cache. It does not affect the item's recency.
 func (c *Cache[TKey, TValue]) Has(key TKey) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
 	item, ok := c.items[key]
 	if !ok {
 		return false
@@ -84,11 +132,20 @@ func (c *Cache[TKey, TValue]) Has(key TKey) bool {
 // Remove removes the provided key from the cache.
 func (c *Cache[TKey, TValue]) Remove(key TKey) {
 	c.mtx.Lock()
-	defer c.mtx.Unlock()
-	delete(c.items, key)
+	item, ok := c.items[key]
+	if !ok {
+		c.mtx.Unlock()
+		return
+	}
+	val := item.val
+	c.remove(key)
+	c.mtx.Unlock()
+
+	c.notifyEviction(key, val, ReasonRemoved)
 }
 
-// Keys returns a slice of the keys in the cache.
+// Keys returns a slice of the keys in the cache. It does not affect the
+// recency of any item.
 func (c *Cache[TKey, TValue]) Keys(checkExpired bool) []TKey {
 	c.mtx.RLock()
 	defer c.mtx.RUnlock()
@@ -103,6 +160,8 @@ func (c *Cache[TKey, TValue]) Keys(checkExpired bool) []TKey {
 
 // Len returns the number of items in the cache.
 func (c *Cache[TKey, TValue]) Len(checkExpired bool) int {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
 	if !checkExpired {
 		return len(c.items)
 	}
@@ -115,54 +174,123 @@ func (c *Cache[TKey, TValue]) Len(checkExpired bool) int {
 	return length
 }
 
+// Cap returns the capacity the cache is bounded to, or 0 if it is unbounded.
+func (c *Cache[TKey, TValue]) Cap() int {
+	return c.capacity
+}
+
 // Purge completely clears the cache
 func (c *Cache[TKey, TValue]) Purge() {
-	c.initItems()
+	c.mtx.Lock()
+	purged := c.items
+	c.resetItems()
+	c.mtx.Unlock()
+
+	for k, item := range purged {
+		c.notifyEviction(k, item.val, ReasonPurged)
+	}
 }
 
-func (c *Cache[TKey, TValue]) init(exp time.Duration) {
+func (c *Cache[TKey, TValue]) init() {
 	c.initItems()
-	c.ttl = exp
 }
 
-func (c *Cache[TKey, TValue]) set(key TKey, value TValue, ttl time.Duration) {
-	c.mtx.RLock()
+// remove deletes key from items and its node from the recency list.
+// Callers must hold c.mtx for writing.
+func (c *Cache[TKey, TValue]) remove(key TKey) {
 	item, ok := c.items[key]
-	c.mtx.RUnlock()
+	if !ok {
+		return
+	}
+	c.order.Remove(item.element)
+	delete(c.items, key)
+	c.removeExpiration(key)
+}
+
+func (c *Cache[TKey, TValue]) set(key TKey, value TValue, ttl time.Duration) {
 	if ttl < 1 {
 		ttl = c.ttl
 	}
-	if !ok {
-		item = cacheItem[TValue]{}
-	}
+	c.setRaw(key, value, ttl)
+}
 
+// setRaw inserts key-value with ttl exactly as given, without substituting
+// the cache's default TTL for a zero/negative value. Used by set (after it
+// has resolved the default) and by Load, which must preserve a persisted
+// item's original "never expire" (ttl <= 0) intent regardless of the
+// loading cache's own default TTL.
+func (c *Cache[TKey, TValue]) setRaw(key TKey, value TValue, ttl time.Duration) {
+	c.mtx.Lock()
+	item, replaced := c.items[key]
+	var oldVal TValue
+	if replaced {
+		oldVal = item.val
+		c.order.MoveToFront(item.element)
+	} else {
+		item = &cacheItem[TKey, TValue]{}
+		item.element = c.order.PushFront(key)
+		c.items[key] = item
+	}
 	item.ttl = ttl
 	item.val = value
 	item.added = time.Now()
-	c.mtx.Lock()
-	c.items[key] = item
+	c.pushExpiration(key, ttl, item.added)
+	c.counters.insertions.Add(1)
+
+	var evictedKey TKey
+	var evictedVal TValue
+	evicted := false
+	if c.capacity > 0 && len(c.items) > c.capacity {
+		if oldest := c.order.Back(); oldest != nil {
+			evictedKey = oldest.Value.(TKey)
+			evictedVal = c.items[evictedKey].val
+			c.remove(evictedKey)
+			evicted = true
+		}
+	}
 	c.mtx.Unlock()
 
-	if c.addedFunc != nil {
-		c.addedFunc(key, value)
+	if replaced {
+		c.notifyEviction(key, oldVal, ReasonReplaced)
+	}
+	c.notifyInsertion(key, value)
+	if evicted {
+		c.notifyEviction(evictedKey, evictedVal, ReasonCapacity)
 	}
+	c.kickJanitor()
 }
 
 func (c *Cache[TKey, TValue]) initItems() {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
-	c.items = make(map[TKey]cacheItem[TValue])
+	c.resetItems()
+}
+
+// resetItems (re)allocates items, order and the expiration index.
+// Callers must hold c.mtx for writing.
+func (c *Cache[TKey, TValue]) resetItems() {
+	c.items = make(map[TKey]*cacheItem[TKey, TValue])
+	c.order = list.New()
+	c.expHeap = nil
+	c.expIndex = make(map[TKey]*expirationItem[TKey])
 	c.loadGroup = Group[TKey, TValue]{
 		c: c,
 	}
 }
 
 // load a new value using by specified key.
-func (c *Cache[TKey, TValue]) load(key TKey, cb func(TValue,
+func (c *Cache[TKey, TValue]) load(ctx context.Context, key TKey, cb func(TValue,
 	*time.Duration, error) (TValue, error), isWait bool,
 ) (val TValue, isLoaded bool, err error) {
-	v, called, err := c.loadGroup.Do(key, func() (v TValue, e error) {
-		return cb(c.loaderExpireFunc(key))
+	v, called, err := c.loadGroup.Do(ctx, key, func(loaderCtx context.Context) (v TValue, e error) {
+		start := time.Now()
+		val, exp, lerr := c.loaderExpireFunc(loaderCtx, key)
+		c.counters.loaderCalls.Add(1)
+		c.counters.loaderLatencySum.Add(uint64(time.Since(start)))
+		if lerr != nil {
+			c.counters.loaderErrors.Add(1)
+		}
+		return cb(val, exp, lerr)
 	}, isWait)
 	if err != nil {
 		var def TValue
@@ -172,27 +300,36 @@ func (c *Cache[TKey, TValue]) load(key TKey, cb func(TValue,
 }
 
 func (c *Cache[TKey, TValue]) get(key TKey) (TValue, error) {
-	c.mtx.RLock()
+	c.mtx.Lock()
 	item, ok := c.items[key]
-	c.mtx.RUnlock()
-	if ok {
-		if !item.expired() {
-			return item.val, nil
-		}
-		c.mtx.Lock()
-		delete(c.items, key)
+	if !ok {
 		c.mtx.Unlock()
+		c.counters.misses.Add(1)
+		var def TValue
+		return def, ErrNotFound
 	}
-	var def TValue
-	return def, ErrNotFound
+	if item.expired() {
+		val := item.val
+		c.remove(key)
+		c.mtx.Unlock()
+		c.counters.misses.Add(1)
+		c.notifyEviction(key, val, ReasonExpired)
+		var def TValue
+		return def, ErrNotFound
+	}
+	c.order.MoveToFront(item.element)
+	val := item.val
+	c.mtx.Unlock()
+	c.counters.hits.Add(1)
+	return val, nil
 }
 
-func (c *Cache[TKey, TValue]) getWithLoader(key TKey, isWait bool) (TValue, error) {
+func (c *Cache[TKey, TValue]) getWithLoader(ctx context.Context, key TKey, isWait bool) (TValue, error) {
 	var def TValue
 	if c.loaderExpireFunc == nil {
 		return def, ErrNotFound
 	}
-	value, _, err := c.load(key, func(v TValue, expiration *time.Duration, e error) (TValue, error) {
+	value, _, err := c.load(ctx, key, func(v TValue, expiration *time.Duration, e error) (TValue, error) {
 		if e != nil {
 			return def, e
 		}
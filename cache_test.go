@@ -230,6 +230,50 @@ func (s *CacheSuite) TestCalcSet() {
 	s.Require().Equal(25, v)
 }
 
+// TestLRUEviction ensures the least recently used item is evicted once
+// capacity is exceeded, and that Get bumps an item's recency.
+func (s *CacheSuite) TestLRUEviction() {
+	var (
+		validate = s.Assert()
+		cc       = NewWithCapacity[int, string](time.Minute, 2)
+	)
+
+	cc.Set(1, "a")
+	cc.Set(2, "b")
+
+	// touching 1 makes 2 the least recently used
+	_, err := cc.Get(1)
+	validate.NoError(err)
+
+	cc.Set(3, "c")
+
+	validate.False(cc.Has(2))
+	_, err = cc.Get(2)
+	validate.ErrorIs(err, ErrNotFound)
+
+	v, err := cc.Get(1)
+	validate.NoError(err)
+	validate.Equal("a", v)
+
+	v, err = cc.Get(3)
+	validate.NoError(err)
+	validate.Equal("c", v)
+
+	validate.Equal(2, cc.Len(false))
+}
+
+// TestCap ensures Cap reports the configured capacity, and 0 for unbounded caches.
+func (s *CacheSuite) TestCap() {
+	var (
+		validate = s.Assert()
+		bounded  = NewWithCapacity[int, int](time.Minute, 4)
+		open     = New[int, int](time.Minute)
+	)
+
+	validate.Equal(4, bounded.Cap())
+	validate.Equal(0, open.Cap())
+}
+
 func (s *CacheSuite) TestConcurrentUpdate() {
 	var (
 		validate = s.Assert()
@@ -6,15 +6,25 @@
 // license that can be found in the LICENSE file.
 package cache
 
-import "time"
+import (
+	"container/list"
+	"time"
+)
 
-type cacheItem[V any] struct {
-	val   V
-	ttl   time.Duration
-	added time.Time
+// cacheItem holds a cached value together with its expiration and recency
+// bookkeeping. element points at the node this item occupies in the
+// owning Cache's recency list, so Get/Set/Update can bump it to the front
+// in O(1). It is always populated, regardless of capacity - Set maintains
+// the recency list for every cache, capacity only decides whether it is
+// ever consulted to evict the tail.
+type cacheItem[K comparable, V any] struct {
+	val     V
+	ttl     time.Duration
+	added   time.Time
+	element *list.Element
 }
 
-func (c cacheItem[V]) expired() bool {
+func (c *cacheItem[K, V]) expired() bool {
 	if c.ttl <= 0 {
 		return false
 	}
@@ -51,7 +51,7 @@ func (s *CacheItemSuite) TestExpired() {
 		s.Run(tc.title, func() {
 			var (
 				validate = s.Assert()
-				ci       = &cacheItem[string]{}
+				ci       = &cacheItem[string, string]{}
 			)
 			ci.added = tc.added
 			ci.ttl = tc.ttl
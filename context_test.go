@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ContextSuite struct {
+	suite.Suite
+}
+
+func TestContextSuite(t *testing.T) {
+	suite.Run(t, &ContextSuite{})
+}
+
+// TestGetContextCancelFirstCaller ensures the first (and only) caller
+// cancelling its context aborts the in-flight loader wait.
+func (s *ContextSuite) TestGetContextCancelFirstCaller() {
+	var (
+		validate = s.Assert()
+		started  = make(chan struct{})
+		release  = make(chan struct{})
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.LoaderFuncCtx(func(ctx context.Context, key string) (int, error) {
+		close(started)
+		<-release
+		return 42, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := cc.GetContext(ctx, "a")
+	validate.ErrorIs(err, context.Canceled)
+	close(release)
+}
+
+// TestGetContextCancelLaterCaller ensures a later waiter cancelling its own
+// context only affects that waiter, not the in-flight call.
+func (s *ContextSuite) TestGetContextCancelLaterCaller() {
+	var (
+		validate = s.Assert()
+		started  = make(chan struct{})
+		release  = make(chan struct{})
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.LoaderFuncCtx(func(ctx context.Context, key string) (int, error) {
+		close(started)
+		<-release
+		return 42, nil
+	})
+
+	firstDone := make(chan struct{})
+	go func() {
+		defer close(firstDone)
+		v, err := cc.GetContext(context.Background(), "a")
+		validate.NoError(err)
+		validate.Equal(42, v)
+	}()
+
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := cc.GetContext(ctx, "a")
+	validate.ErrorIs(err, context.Canceled)
+
+	close(release)
+	<-firstDone
+}
+
+// TestGetContextPromotesToRemainingWaiter ensures that when the first caller
+// cancels but another waiter remains, the loader keeps running and the
+// remaining waiter still gets the result.
+func (s *ContextSuite) TestGetContextPromotesToRemainingWaiter() {
+	var (
+		validate   = s.Assert()
+		started    = make(chan struct{})
+		loaderDone atomic.Bool
+		cc         = New[string, int](time.Minute)
+	)
+
+	cc.LoaderFuncCtx(func(ctx context.Context, key string) (int, error) {
+		close(started)
+		select {
+		case <-ctx.Done():
+			return 0, errors.New("loader was cancelled even though a waiter remained")
+		case <-time.After(100 * time.Millisecond):
+			loaderDone.Store(true)
+			return 42, nil
+		}
+	})
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	var firstErr, secondErr error
+	var secondVal int
+
+	go func() {
+		defer wg.Done()
+		_, firstErr = cc.GetContext(firstCtx, "a")
+	}()
+
+	go func() {
+		<-started
+		defer wg.Done()
+		secondVal, secondErr = cc.GetContext(context.Background(), "a")
+	}()
+
+	// Give the second caller time to register as a waiter on the in-flight
+	// call before cancelling the first; the loader sleeps 100ms, leaving a
+	// wide margin.
+	<-started
+	time.Sleep(20 * time.Millisecond)
+	cancelFirst()
+
+	wg.Wait()
+
+	validate.ErrorIs(firstErr, context.Canceled)
+	validate.NoError(secondErr)
+	validate.Equal(42, secondVal)
+	validate.True(loaderDone.Load())
+}
@@ -0,0 +1,77 @@
+package cache
+
+import "sync"
+
+// subscribers holds the OnInsertion/OnEviction callbacks registered for a
+// Cache. It is zero-value ready; the maps are created lazily on first
+// subscription.
+type subscribers[TKey comparable, TValue any] struct {
+	mtx       sync.RWMutex
+	nextID    uint64
+	insertion map[uint64]AddedFunc[TKey, TValue]
+	eviction  map[uint64]EvictionFunc[TKey, TValue]
+}
+
+// OnInsertion registers fn to be called, outside the cache's internal lock,
+// every time a key-value pair is inserted or updated. Multiple subscribers
+// are supported; the returned function removes this one.
+func (c *Cache[TKey, TValue]) OnInsertion(fn AddedFunc[TKey, TValue]) (unsubscribe func()) {
+	c.subs.mtx.Lock()
+	defer c.subs.mtx.Unlock()
+	if c.subs.insertion == nil {
+		c.subs.insertion = make(map[uint64]AddedFunc[TKey, TValue])
+	}
+	id := c.subs.nextID
+	c.subs.nextID++
+	c.subs.insertion[id] = fn
+	return func() {
+		c.subs.mtx.Lock()
+		delete(c.subs.insertion, id)
+		c.subs.mtx.Unlock()
+	}
+}
+
+// OnEviction registers fn to be called, outside the cache's internal lock,
+// every time an item leaves the cache, along with the EvictionReason it left
+// for. Multiple subscribers are supported; the returned function removes
+// this one.
+func (c *Cache[TKey, TValue]) OnEviction(fn EvictionFunc[TKey, TValue]) (unsubscribe func()) {
+	c.subs.mtx.Lock()
+	defer c.subs.mtx.Unlock()
+	if c.subs.eviction == nil {
+		c.subs.eviction = make(map[uint64]EvictionFunc[TKey, TValue])
+	}
+	id := c.subs.nextID
+	c.subs.nextID++
+	c.subs.eviction[id] = fn
+	return func() {
+		c.subs.mtx.Lock()
+		delete(c.subs.eviction, id)
+		c.subs.mtx.Unlock()
+	}
+}
+
+func (c *Cache[TKey, TValue]) notifyInsertion(key TKey, value TValue) {
+	c.subs.mtx.RLock()
+	fns := make([]AddedFunc[TKey, TValue], 0, len(c.subs.insertion))
+	for _, fn := range c.subs.insertion {
+		fns = append(fns, fn)
+	}
+	c.subs.mtx.RUnlock()
+	for _, fn := range fns {
+		fn(key, value)
+	}
+}
+
+func (c *Cache[TKey, TValue]) notifyEviction(key TKey, value TValue, reason EvictionReason) {
+	c.counters.evictions.Add(1)
+	c.subs.mtx.RLock()
+	fns := make([]EvictionFunc[TKey, TValue], 0, len(c.subs.eviction))
+	for _, fn := range c.subs.eviction {
+		fns = append(fns, fn)
+	}
+	c.subs.mtx.RUnlock()
+	for _, fn := range fns {
+		fn(key, value, reason)
+	}
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type EventsSuite struct {
+	suite.Suite
+}
+
+func TestEventsSuite(t *testing.T) {
+	suite.Run(t, &EventsSuite{})
+}
+
+// TestOnInsertion ensures every subscriber is notified on insertion, and that
+// unsubscribing stops further notifications.
+func (s *EventsSuite) TestOnInsertion() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+		calls    []int
+	)
+
+	unsubscribe := cc.OnInsertion(func(key string, val int) {
+		calls = append(calls, val)
+	})
+
+	cc.Set("a", 1)
+	cc.Set("a", 2)
+	validate.Equal([]int{1, 2}, calls)
+
+	unsubscribe()
+	cc.Set("a", 3)
+	validate.Equal([]int{1, 2}, calls)
+}
+
+// TestOnEviction ensures eviction subscribers observe the correct reason for
+// explicit removal, replacement, purge and capacity eviction.
+func (s *EventsSuite) TestOnEviction() {
+	var (
+		validate = s.Assert()
+		cc       = NewWithCapacity[string, int](time.Minute, 1)
+		reasons  []EvictionReason
+	)
+
+	cc.OnEviction(func(key string, val int, reason EvictionReason) {
+		reasons = append(reasons, reason)
+	})
+
+	cc.Set("a", 1)
+	cc.Set("a", 2) // replaced
+	validate.Equal([]EvictionReason{ReasonReplaced}, reasons)
+
+	cc.Set("b", 1) // evicts "a" for capacity
+	validate.Equal([]EvictionReason{ReasonReplaced, ReasonCapacity}, reasons)
+
+	cc.Remove("b")
+	validate.Equal([]EvictionReason{ReasonReplaced, ReasonCapacity, ReasonRemoved}, reasons)
+
+	cc.Set("c", 1)
+	cc.Purge()
+	validate.Equal([]EvictionReason{ReasonReplaced, ReasonCapacity, ReasonRemoved, ReasonPurged}, reasons)
+}
+
+// TestAddedFuncDeprecatedCompat ensures the deprecated AddedFunc still fires
+// via the new OnInsertion subscriber model.
+func (s *EventsSuite) TestAddedFuncDeprecatedCompat() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+		called   bool
+	)
+
+	cc.AddedFunc(func(key string, val int) {
+		called = true
+	})
+
+	cc.Set("a", 1)
+	validate.True(called)
+}
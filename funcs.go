@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"time"
 )
 
@@ -11,11 +12,17 @@ type (
 	LoaderFunc[TKey comparable, TValue any] func(TKey) (TValue, error)
 	// LoaderExpireFunc is called when item is expired
 	LoaderExpireFunc[TKey comparable, TValue any] func(TKey) (TValue, *time.Duration, error)
+	// EvictionFunc is called with the key, value and reason whenever an item leaves the cache
+	EvictionFunc[TKey comparable, TValue any] func(TKey, TValue, EvictionReason)
+	// LoaderFuncCtx is a LoaderFunc that can be cancelled via ctx
+	LoaderFuncCtx[TKey comparable, TValue any] func(ctx context.Context, key TKey) (TValue, error)
+	// LoaderExpireFuncCtx is a LoaderExpireFunc that can be cancelled via ctx
+	LoaderExpireFuncCtx[TKey comparable, TValue any] func(ctx context.Context, key TKey) (TValue, *time.Duration, error)
 )
 
 // LoaderFunc: create a new value with this function if cached value is expired.
 func (c *Cache[TKey, TValue]) LoaderFunc(loaderFunc LoaderFunc[TKey, TValue]) *Cache[TKey, TValue] {
-	c.loaderExpireFunc = func(k TKey) (TValue, *time.Duration, error) {
+	c.loaderExpireFunc = func(_ context.Context, k TKey) (TValue, *time.Duration, error) {
 		v, err := loaderFunc(k)
 		return v, nil, err
 	}
@@ -25,12 +32,36 @@ func (c *Cache[TKey, TValue]) LoaderFunc(loaderFunc LoaderFunc[TKey, TValue]) *C
 // LoaderExpireFunc - loader function with expiration, create a new value with this function if cached value is expired.
 // If nil returned instead of time.Duration from loaderExpireFunc than value will never expire.
 func (c *Cache[TKey, TValue]) LoaderExpireFunc(loaderExpireFunc LoaderExpireFunc[TKey, TValue]) *Cache[TKey, TValue] {
+	c.loaderExpireFunc = func(_ context.Context, k TKey) (TValue, *time.Duration, error) {
+		return loaderExpireFunc(k)
+	}
+	return c
+}
+
+// LoaderFuncCtx is like LoaderFunc, but the loader receives a context that
+// is cancelled once every GetContext caller waiting on that key has had its
+// own context cancelled (see Group.Do and callContext), so a slow upstream
+// can be cancelled instead of tying up the singleflight Group indefinitely.
+// Values are looked up in the first caller's context; no deadline is set.
+func (c *Cache[TKey, TValue]) LoaderFuncCtx(loaderFunc LoaderFuncCtx[TKey, TValue]) *Cache[TKey, TValue] {
+	c.loaderExpireFunc = func(ctx context.Context, k TKey) (TValue, *time.Duration, error) {
+		v, err := loaderFunc(ctx, k)
+		return v, nil, err
+	}
+	return c
+}
+
+// LoaderExpireFuncCtx is the context-aware, expiration-aware counterpart of
+// LoaderFuncCtx and LoaderExpireFunc combined.
+func (c *Cache[TKey, TValue]) LoaderExpireFuncCtx(loaderExpireFunc LoaderExpireFuncCtx[TKey, TValue]) *Cache[TKey, TValue] {
 	c.loaderExpireFunc = loaderExpireFunc
 	return c
 }
 
 // AddedFunc - if provided, this function will be called after each new value is added to the cache
+//
+// Deprecated: use OnInsertion instead, which supports multiple subscribers and an unsubscribe function.
 func (c *Cache[TKey, TValue]) AddedFunc(addedFunc AddedFunc[TKey, TValue]) *Cache[TKey, TValue] {
-	c.addedFunc = addedFunc
+	c.OnInsertion(addedFunc)
 	return c
 }
@@ -0,0 +1,27 @@
+package cache
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// HashString is a default hasher for NewSharded over string keys.
+func HashString(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
+
+// HashInt is a default hasher for NewSharded over int keys.
+func HashInt(key int) uint64 {
+	return HashInt64(int64(key))
+}
+
+// HashInt64 is a default hasher for NewSharded over int64 keys.
+func HashInt64(key int64) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(key))
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	return h.Sum64()
+}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"time"
 )
 
@@ -16,7 +17,12 @@ type Cacher[TKey comparable, TValue any] interface {
 	// If the key is not present in the cache and the cache does not have a LoaderFunc,
 	// return KeyNotFoundError.
 	Get(key TKey) (TValue, error)
+	// GetContext is like Get, but ctx is passed to a LoaderFuncCtx/LoaderExpireFuncCtx
+	// so a slow loader can be cancelled.
+	GetContext(ctx context.Context, key TKey) (TValue, error)
 	get(key TKey) (TValue, error)
+	// Update atomically updates a value using the given function to calculate the new value.
+	Update(key TKey, calc func(v TValue) TValue)
 	// Remove removes the specified key from the cache if the key is present.
 	Remove(key TKey)
 	// Purge removes all key-value pairs from the cache.
@@ -27,4 +33,12 @@ type Cacher[TKey comparable, TValue any] interface {
 	Len(checkExpired bool) int
 	// Has returns true if the key exists in the cache.
 	Has(key TKey) bool
+	// Cap returns the capacity the cache is bounded to, or 0 if it is unbounded.
+	Cap() int
+	// Metrics returns a snapshot of the cache's usage counters.
+	Metrics() Metrics
+	// Stop terminates any background janitor goroutine started via
+	// WithJanitor. It is a no-op for caches created without that option, and
+	// must not be called more than once.
+	Stop()
 }
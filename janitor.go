@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"container/heap"
+	"time"
+)
+
+// expirationItem is a single entry in a Cache's expiration heap, tracking
+// when key is due to expire. index is maintained by expirationHeap so the
+// item can be removed or re-prioritized in O(log n) without a linear scan.
+type expirationItem[TKey comparable] struct {
+	key      TKey
+	deadline time.Time
+	index    int
+}
+
+// expirationHeap is a container/heap min-heap of expirationItem ordered by
+// deadline, so the janitor goroutine can always find the next item due to
+// expire in O(1).
+type expirationHeap[TKey comparable] []*expirationItem[TKey]
+
+func (h expirationHeap[TKey]) Len() int { return len(h) }
+
+func (h expirationHeap[TKey]) Less(i, j int) bool { return h[i].deadline.Before(h[j].deadline) }
+
+func (h expirationHeap[TKey]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expirationHeap[TKey]) Push(x any) {
+	item := x.(*expirationItem[TKey])
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+func (h *expirationHeap[TKey]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*h = old[:n-1]
+	return item
+}
+
+// pushExpiration upserts key's deadline in the expiration heap. ttl <= 0
+// means the item never expires and is not tracked. Callers must hold c.mtx.
+func (c *Cache[TKey, TValue]) pushExpiration(key TKey, ttl time.Duration, added time.Time) {
+	if !c.janitorEnabled {
+		return
+	}
+	if ttl <= 0 {
+		c.removeExpiration(key)
+		return
+	}
+	deadline := added.Add(ttl)
+	if item, ok := c.expIndex[key]; ok {
+		item.deadline = deadline
+		heap.Fix(&c.expHeap, item.index)
+		return
+	}
+	item := &expirationItem[TKey]{key: key, deadline: deadline}
+	heap.Push(&c.expHeap, item)
+	c.expIndex[key] = item
+}
+
+// removeExpiration drops key from the expiration heap, if present. Callers
+// must hold c.mtx.
+func (c *Cache[TKey, TValue]) removeExpiration(key TKey) {
+	if !c.janitorEnabled {
+		return
+	}
+	item, ok := c.expIndex[key]
+	if !ok {
+		return
+	}
+	heap.Remove(&c.expHeap, item.index)
+	delete(c.expIndex, key)
+}
+
+// startJanitor starts the background goroutine that actively expires items,
+// instead of relying solely on lazy expiration inside get.
+func (c *Cache[TKey, TValue]) startJanitor() {
+	c.timerCh = make(chan time.Duration, 1)
+	c.stopCh = make(chan struct{})
+	c.janitorWG.Add(1)
+	go c.runJanitor()
+}
+
+// Stop terminates the background janitor goroutine started via WithJanitor.
+// It is a no-op for caches created without that option, and must not be
+// called more than once.
+func (c *Cache[TKey, TValue]) Stop() {
+	if !c.janitorEnabled {
+		return
+	}
+	close(c.stopCh)
+	c.janitorWG.Wait()
+}
+
+func (c *Cache[TKey, TValue]) runJanitor() {
+	defer c.janitorWG.Done()
+
+	timer := time.NewTimer(time.Hour)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case d := <-c.timerCh:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			if d <= 0 {
+				c.expireDue()
+				continue
+			}
+			timer.Reset(d)
+		case <-timer.C:
+			c.expireDue()
+		}
+	}
+}
+
+// expireDue removes every item whose deadline has passed, dispatches
+// ReasonExpired eviction events for them, and re-arms the janitor for the
+// next deadline still in the heap.
+func (c *Cache[TKey, TValue]) expireDue() {
+	type due struct {
+		key TKey
+		val TValue
+	}
+	now := time.Now()
+
+	c.mtx.Lock()
+	var expired []due
+	for len(c.expHeap) > 0 && !c.expHeap[0].deadline.After(now) {
+		item := heap.Pop(&c.expHeap).(*expirationItem[TKey])
+		delete(c.expIndex, item.key)
+		if ci, ok := c.items[item.key]; ok {
+			expired = append(expired, due{item.key, ci.val})
+			c.order.Remove(ci.element)
+			delete(c.items, item.key)
+		}
+	}
+	nextDelay, hasNext := c.nextDeadlineLocked()
+	c.mtx.Unlock()
+
+	for _, e := range expired {
+		c.notifyEviction(e.key, e.val, ReasonExpired)
+	}
+	if hasNext {
+		c.sendTimer(nextDelay)
+	}
+}
+
+// nextDeadlineLocked returns how long until the earliest tracked deadline,
+// clamped to 0 if it is already due. Callers must hold c.mtx.
+func (c *Cache[TKey, TValue]) nextDeadlineLocked() (time.Duration, bool) {
+	if len(c.expHeap) == 0 {
+		return 0, false
+	}
+	d := time.Until(c.expHeap[0].deadline)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
+
+// kickJanitor wakes the janitor goroutine so it re-arms its timer against
+// the current earliest deadline, e.g. after a Set moved it earlier.
+func (c *Cache[TKey, TValue]) kickJanitor() {
+	if !c.janitorEnabled {
+		return
+	}
+	c.mtx.RLock()
+	d, has := c.nextDeadlineLocked()
+	c.mtx.RUnlock()
+	if has {
+		c.sendTimer(d)
+	}
+}
+
+// sendTimer delivers d to the janitor goroutine, replacing any duration
+// still pending in the (size-1) channel with the latest one.
+func (c *Cache[TKey, TValue]) sendTimer(d time.Duration) {
+	select {
+	case <-c.timerCh:
+	default:
+	}
+	select {
+	case c.timerCh <- d:
+	default:
+	}
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+type JanitorSuite struct {
+	suite.Suite
+}
+
+func TestJanitorSuite(t *testing.T) {
+	suite.Run(t, &JanitorSuite{})
+}
+
+// TestActiveExpiration ensures an item is evicted by the janitor goroutine
+// on its own, without ever being read back via Get.
+func (s *JanitorSuite) TestActiveExpiration() {
+	var (
+		validate = s.Assert()
+		cc       = NewWithOptions[string, int](WithTTL[string, int](50*time.Millisecond), WithJanitor[string, int]())
+		reasons  = make(chan EvictionReason, 1)
+	)
+	defer cc.Stop()
+
+	cc.OnEviction(func(key string, val int, reason EvictionReason) {
+		reasons <- reason
+	})
+	cc.Set("a", 1)
+
+	select {
+	case reason := <-reasons:
+		validate.Equal(ReasonExpired, reason)
+	case <-time.After(time.Second):
+		s.Fail("item was not actively expired by the janitor")
+	}
+
+	validate.Equal(0, cc.Len(false))
+}
+
+// TestJanitorStopIsSafeWithoutOption ensures Stop is a no-op for caches
+// created without WithJanitor.
+func (s *JanitorSuite) TestJanitorStopIsSafeWithoutOption() {
+	cc := New[string, int](time.Minute)
+	cc.Stop()
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Metrics is a point-in-time snapshot of a Cache's usage counters, returned
+// by Cache.Metrics.
+type Metrics struct {
+	Hits             uint64
+	Misses           uint64
+	Insertions       uint64
+	Evictions        uint64
+	LoaderCalls      uint64
+	LoaderErrors     uint64
+	AvgLoaderLatency time.Duration
+}
+
+// counters holds the atomic counters backing Cache.Metrics. It is zero-value
+// ready and cheap enough to stay on by default.
+type counters struct {
+	hits             atomic.Uint64
+	misses           atomic.Uint64
+	insertions       atomic.Uint64
+	evictions        atomic.Uint64
+	loaderCalls      atomic.Uint64
+	loaderErrors     atomic.Uint64
+	loaderLatencySum atomic.Uint64 // nanoseconds
+}
+
+// Metrics returns a snapshot of the cache's usage counters.
+func (c *Cache[TKey, TValue]) Metrics() Metrics {
+	calls := c.counters.loaderCalls.Load()
+	var avg time.Duration
+	if calls > 0 {
+		avg = time.Duration(c.counters.loaderLatencySum.Load() / calls)
+	}
+	return Metrics{
+		Hits:             c.counters.hits.Load(),
+		Misses:           c.counters.misses.Load(),
+		Insertions:       c.counters.insertions.Load(),
+		Evictions:        c.counters.evictions.Load(),
+		LoaderCalls:      calls,
+		LoaderErrors:     c.counters.loaderErrors.Load(),
+		AvgLoaderLatency: avg,
+	}
+}
+
+// ResetMetrics zeroes all usage counters.
+func (c *Cache[TKey, TValue]) ResetMetrics() {
+	c.counters.hits.Store(0)
+	c.counters.misses.Store(0)
+	c.counters.insertions.Store(0)
+	c.counters.evictions.Store(0)
+	c.counters.loaderCalls.Store(0)
+	c.counters.loaderErrors.Store(0)
+	c.counters.loaderLatencySum.Store(0)
+}
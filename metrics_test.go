@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricsSuite struct {
+	suite.Suite
+}
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, &MetricsSuite{})
+}
+
+// TestHitsAndMisses ensures Get increments Hits and Misses correctly.
+func (s *MetricsSuite) TestHitsAndMisses() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.Set("a", 1)
+	_, err := cc.Get("a")
+	validate.NoError(err)
+	_, err = cc.Get("missing")
+	validate.Error(err)
+
+	m := cc.Metrics()
+	validate.Equal(uint64(1), m.Hits)
+	validate.Equal(uint64(1), m.Misses)
+	validate.Equal(uint64(1), m.Insertions)
+}
+
+// TestEvictions ensures an explicit Remove is counted as an eviction.
+func (s *MetricsSuite) TestEvictions() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.Set("a", 1)
+	cc.Remove("a")
+
+	validate.Equal(uint64(1), cc.Metrics().Evictions)
+}
+
+// TestLoaderMetrics ensures loader calls, errors and latency are tracked.
+func (s *MetricsSuite) TestLoaderMetrics() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.LoaderFunc(func(key string) (int, error) {
+		time.Sleep(10 * time.Millisecond)
+		if key == "bad" {
+			return 0, errors.New("boom")
+		}
+		return 42, nil
+	})
+
+	_, err := cc.Get("ok")
+	validate.NoError(err)
+	_, err = cc.Get("bad")
+	validate.Error(err)
+
+	m := cc.Metrics()
+	validate.Equal(uint64(2), m.LoaderCalls)
+	validate.Equal(uint64(1), m.LoaderErrors)
+	validate.GreaterOrEqual(m.AvgLoaderLatency, 10*time.Millisecond)
+}
+
+// TestResetMetrics ensures ResetMetrics zeroes all counters.
+func (s *MetricsSuite) TestResetMetrics() {
+	var (
+		validate = s.Assert()
+		cc       = New[string, int](time.Minute)
+	)
+
+	cc.Set("a", 1)
+	_, _ = cc.Get("a")
+	cc.ResetMetrics()
+
+	validate.Equal(Metrics{}, cc.Metrics())
+}
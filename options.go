@@ -0,0 +1,69 @@
+package cache
+
+import "time"
+
+// Option configures a Cache at construction time. Options are applied in
+// order by NewWithOptions (and by NewShardedWithOptions, to every shard)
+// before the cache is ready to use.
+type Option[TKey comparable, TValue any] func(*Cache[TKey, TValue])
+
+// WithTTL sets the default expiration applied to items set without an
+// explicit one (e.g. via Set, as opposed to SetWithExpire). 0 = never expire.
+func WithTTL[TKey comparable, TValue any](ttl time.Duration) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.ttl = ttl
+	}
+}
+
+// WithCapacity bounds the cache to at most capacity items, evicting the
+// least recently used one on every Set that would exceed it. capacity <= 0
+// means unbounded.
+func WithCapacity[TKey comparable, TValue any](capacity int) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.capacity = capacity
+	}
+}
+
+// WithJanitor enables active expiration: a background goroutine that wakes
+// up as each item's TTL elapses and deletes it, instead of relying solely on
+// lazy expiration inside Get. Call Stop to terminate the goroutine once the
+// cache is no longer needed.
+func WithJanitor[TKey comparable, TValue any]() Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.janitorEnabled = true
+	}
+}
+
+// WithLoaderFunc wires loaderFunc in at construction time, equivalent to
+// calling LoaderFunc afterwards. This is the only way to install a loader on
+// every shard of a NewShardedWithOptions cache, since the concrete *Cache
+// per shard isn't otherwise reachable.
+func WithLoaderFunc[TKey comparable, TValue any](loaderFunc LoaderFunc[TKey, TValue]) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.LoaderFunc(loaderFunc)
+	}
+}
+
+// WithLoaderExpireFunc wires loaderExpireFunc in at construction time,
+// equivalent to calling LoaderExpireFunc afterwards. See WithLoaderFunc.
+func WithLoaderExpireFunc[TKey comparable, TValue any](loaderExpireFunc LoaderExpireFunc[TKey, TValue]) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.LoaderExpireFunc(loaderExpireFunc)
+	}
+}
+
+// WithLoaderFuncCtx wires loaderFunc in at construction time, equivalent to
+// calling LoaderFuncCtx afterwards. See WithLoaderFunc.
+func WithLoaderFuncCtx[TKey comparable, TValue any](loaderFunc LoaderFuncCtx[TKey, TValue]) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.LoaderFuncCtx(loaderFunc)
+	}
+}
+
+// WithLoaderExpireFuncCtx wires loaderExpireFunc in at construction time,
+// equivalent to calling LoaderExpireFuncCtx afterwards. See WithLoaderFunc.
+func WithLoaderExpireFuncCtx[TKey comparable, TValue any](loaderExpireFunc LoaderExpireFuncCtx[TKey, TValue]) Option[TKey, TValue] {
+	return func(c *Cache[TKey, TValue]) {
+		c.LoaderExpireFuncCtx(loaderExpireFunc)
+	}
+}
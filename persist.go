@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"encoding/gob"
+	"io"
+	"os"
+	"time"
+)
+
+// persistedItem is the on-disk gob representation of one live cache entry.
+// Fields must stay exported for gob to (de)serialize them.
+type persistedItem[TKey comparable, TValue any] struct {
+	Key   TKey
+	Value TValue
+	TTL   time.Duration
+	Added time.Time
+}
+
+// Registrable registers a concrete type with encoding/gob so it can be
+// (de)serialized by Save/Load when it is stored as an interface-typed cache
+// value, e.g. cache.Registrable(MyStruct{}). Not needed when TValue is
+// already a concrete type.
+func Registrable(v any) {
+	gob.Register(v)
+}
+
+// Save writes every live (non-expired) item in the cache to w using
+// encoding/gob, so it can later be restored with Load to warm up a cache
+// across a restart. If TValue is an interface type, concrete types held in
+// it must be registered beforehand via Registrable.
+func (c *Cache[TKey, TValue]) Save(w io.Writer) error {
+	c.mtx.RLock()
+	items := make([]persistedItem[TKey, TValue], 0, len(c.items))
+	for k, item := range c.items {
+		if item.expired() {
+			continue
+		}
+		items = append(items, persistedItem[TKey, TValue]{
+			Key:   k,
+			Value: item.val,
+			TTL:   item.ttl,
+			Added: item.added,
+		})
+	}
+	c.mtx.RUnlock()
+
+	return gob.NewEncoder(w).Encode(items)
+}
+
+// Load reads items previously written by Save from r and merges them into
+// the cache, preserving each item's original remaining TTL. Items that have
+// since expired are skipped. Existing keys are overwritten.
+func (c *Cache[TKey, TValue]) Load(r io.Reader) error {
+	var items []persistedItem[TKey, TValue]
+	if err := gob.NewDecoder(r).Decode(&items); err != nil {
+		return err
+	}
+
+	c.umtx.Lock()
+	defer c.umtx.Unlock()
+
+	now := time.Now()
+	for _, it := range items {
+		ttl := it.TTL
+		if ttl > 0 {
+			ttl -= now.Sub(it.Added)
+			if ttl <= 0 {
+				continue
+			}
+		}
+		c.setRaw(it.Key, it.Value, ttl)
+	}
+	return nil
+}
+
+// LoadReplace is like Load, but first purges the cache so the loaded items
+// entirely replace its current contents instead of merging with them.
+func (c *Cache[TKey, TValue]) LoadReplace(r io.Reader) error {
+	c.Purge()
+	return c.Load(r)
+}
+
+// SaveFile is a convenience wrapper around Save that writes to the file at path.
+func (c *Cache[TKey, TValue]) SaveFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Save(f)
+}
+
+// LoadFile is a convenience wrapper around Load that reads from the file at path.
+func (c *Cache[TKey, TValue]) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Load(f)
+}
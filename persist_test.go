@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type PersistSuite struct {
+	suite.Suite
+}
+
+func TestPersistSuite(t *testing.T) {
+	suite.Run(t, &PersistSuite{})
+}
+
+type persistedStruct struct {
+	Name string
+	Tags map[string]int
+}
+
+// TestSaveLoadStructsAndMaps ensures struct and map values round-trip
+// through Save/Load.
+func (s *PersistSuite) TestSaveLoadStructsAndMaps() {
+	var (
+		validate = s.Assert()
+		src      = New[string, persistedStruct](time.Minute)
+	)
+
+	src.SetWithExpire("a", persistedStruct{Name: "alpha", Tags: map[string]int{"x": 1}}, time.Hour)
+	src.Set("never", persistedStruct{Name: "forever"})
+
+	var buf bytes.Buffer
+	validate.NoError(src.Save(&buf))
+
+	dst := New[string, persistedStruct](time.Minute)
+	validate.NoError(dst.Load(&buf))
+
+	v, err := dst.Get("a")
+	validate.NoError(err)
+	validate.Equal("alpha", v.Name)
+	validate.Equal(1, v.Tags["x"])
+
+	v, err = dst.Get("never")
+	validate.NoError(err)
+	validate.Equal("forever", v.Name)
+}
+
+// TestLoadMergesByDefault ensures Load merges into existing entries rather
+// than replacing them, while LoadReplace purges first.
+func (s *PersistSuite) TestLoadMergesByDefault() {
+	var (
+		validate = s.Assert()
+		src      = New[string, int](time.Minute)
+	)
+	src.Set("a", 1)
+
+	var buf bytes.Buffer
+	validate.NoError(src.Save(&buf))
+
+	dst := New[string, int](time.Minute)
+	dst.Set("b", 2)
+	validate.NoError(dst.Load(&buf))
+
+	validate.Equal(2, dst.Len(false))
+	v, err := dst.Get("b")
+	validate.NoError(err)
+	validate.Equal(2, v)
+
+	var buf2 bytes.Buffer
+	validate.NoError(src.Save(&buf2))
+	validate.NoError(dst.LoadReplace(&buf2))
+	validate.Equal(1, dst.Len(false))
+	_, err = dst.Get("b")
+	validate.Error(err)
+}
+
+// TestSaveSkipsExpired ensures items that expired before Save are not persisted.
+func (s *PersistSuite) TestSaveSkipsExpired() {
+	var (
+		validate = s.Assert()
+		src      = New[string, int](1 * time.Nanosecond)
+	)
+	src.Set("a", 1)
+	time.Sleep(time.Millisecond)
+
+	var buf bytes.Buffer
+	validate.NoError(src.Save(&buf))
+
+	dst := New[string, int](time.Minute)
+	validate.NoError(dst.Load(&buf))
+	validate.Equal(0, dst.Len(false))
+}
+
+// TestSaveFileLoadFile ensures the file convenience wrappers round-trip.
+func (s *PersistSuite) TestSaveFileLoadFile() {
+	var (
+		validate = s.Assert()
+		src      = New[string, int](time.Minute)
+		path     = filepath.Join(s.T().TempDir(), "cache.gob")
+	)
+	src.Set("a", 1)
+	validate.NoError(src.SaveFile(path))
+
+	dst := New[string, int](time.Minute)
+	validate.NoError(dst.LoadFile(path))
+
+	v, err := dst.Get("a")
+	validate.NoError(err)
+	validate.Equal(1, v)
+}
+
+// TestRegistrableInterfaceValues ensures Save/Load round-trips an
+// interface-typed cache once the concrete type is registered with gob.
+func (s *PersistSuite) TestRegistrableInterfaceValues() {
+	Registrable(persistedStruct{})
+
+	var (
+		validate = s.Assert()
+		src      = New[string, any](time.Minute)
+	)
+	src.Set("a", persistedStruct{Name: "alpha"})
+
+	var buf bytes.Buffer
+	validate.NoError(src.Save(&buf))
+
+	dst := New[string, any](time.Minute)
+	validate.NoError(dst.Load(&buf))
+
+	v, err := dst.Get("a")
+	validate.NoError(err)
+	validate.Equal(persistedStruct{Name: "alpha"}, v)
+}
@@ -0,0 +1,35 @@
+package cache
+
+// EvictionReason describes why an item left the cache. It is passed to every
+// OnEviction subscriber alongside the key and value that were evicted.
+type EvictionReason int
+
+const (
+	// ReasonExpired means the item's TTL elapsed.
+	ReasonExpired EvictionReason = iota
+	// ReasonCapacity means the item was evicted to keep the cache within its capacity.
+	ReasonCapacity
+	// ReasonRemoved means the item was removed explicitly via Remove.
+	ReasonRemoved
+	// ReasonPurged means the item was cleared via Purge.
+	ReasonPurged
+	// ReasonReplaced means the item was overwritten by a new value set for the same key.
+	ReasonReplaced
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case ReasonExpired:
+		return "expired"
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonRemoved:
+		return "removed"
+	case ReasonPurged:
+		return "purged"
+	case ReasonReplaced:
+		return "replaced"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Sharded spreads its entries across a fixed number of independent Cache
+// instances, keyed by hasher(key) % len(shards), so concurrent Set/Update
+// calls for different keys don't contend on a single mutex. It implements
+// Cacher.
+type Sharded[TKey comparable, TValue any] struct {
+	shards []*Cache[TKey, TValue]
+	hasher func(TKey) uint64
+}
+
+// NewSharded returns a Cacher spread across the given number of shards, each
+// an independent Cache with its own mutex and singleflight Group. hasher
+// picks the shard for a key; see HashString, HashInt and HashInt64 for
+// defaults covering common key types. shards < 1 is treated as 1.
+func NewSharded[TKey comparable, TValue any](exp time.Duration, shards int, hasher func(TKey) uint64) Cacher[TKey, TValue] {
+	return NewShardedWithOptions(shards, hasher, WithTTL[TKey, TValue](exp))
+}
+
+// NewShardedWithOptions returns a Cacher spread across the given number of
+// shards, each an independent Cache built with the same opts - e.g. WithTTL,
+// WithCapacity, WithJanitor, or WithLoaderFunc/WithLoaderExpireFunc (or their
+// Ctx variants) to install a
+// loader that every shard's own Group will singleflight independently.
+// hasher picks the shard for a key; see HashString, HashInt and HashInt64
+// for defaults covering common key types. shards < 1 is treated as 1.
+func NewShardedWithOptions[TKey comparable, TValue any](shards int, hasher func(TKey) uint64, opts ...Option[TKey, TValue]) Cacher[TKey, TValue] {
+	if shards < 1 {
+		shards = 1
+	}
+	s := &Sharded[TKey, TValue]{
+		shards: make([]*Cache[TKey, TValue], shards),
+		hasher: hasher,
+	}
+	for i := range s.shards {
+		s.shards[i] = NewWithOptions(opts...)
+	}
+	return s
+}
+
+func (s *Sharded[TKey, TValue]) shardFor(key TKey) *Cache[TKey, TValue] {
+	return s.shards[s.hasher(key)%uint64(len(s.shards))]
+}
+
+// Set inserts or updates the specified key-value pair.
+func (s *Sharded[TKey, TValue]) Set(key TKey, value TValue) {
+	s.shardFor(key).Set(key, value)
+}
+
+// SetWithExpire inserts or updates the specified key-value pair with an expiration time.
+func (s *Sharded[TKey, TValue]) SetWithExpire(key TKey, value TValue, expiration time.Duration) {
+	s.shardFor(key).SetWithExpire(key, value, expiration)
+}
+
+// Get returns the value for the specified key if it is present in the cache.
+func (s *Sharded[TKey, TValue]) Get(key TKey) (TValue, error) {
+	return s.shardFor(key).Get(key)
+}
+
+func (s *Sharded[TKey, TValue]) get(key TKey) (TValue, error) {
+	return s.shardFor(key).get(key)
+}
+
+// GetContext is like Get, but ctx is passed to a LoaderFuncCtx/LoaderExpireFuncCtx
+// so a slow loader can be cancelled.
+func (s *Sharded[TKey, TValue]) GetContext(ctx context.Context, key TKey) (TValue, error) {
+	return s.shardFor(key).GetContext(ctx, key)
+}
+
+// Update atomically updates a value using the given function to calculate the new value.
+func (s *Sharded[TKey, TValue]) Update(key TKey, calc func(v TValue) TValue) {
+	s.shardFor(key).Update(key, calc)
+}
+
+// Remove removes the specified key from the cache if the key is present.
+func (s *Sharded[TKey, TValue]) Remove(key TKey) {
+	s.shardFor(key).Remove(key)
+}
+
+// Purge removes all key-value pairs from every shard.
+func (s *Sharded[TKey, TValue]) Purge() {
+	for _, shard := range s.shards {
+		shard.Purge()
+	}
+}
+
+// Keys returns a slice containing all keys across every shard.
+func (s *Sharded[TKey, TValue]) Keys(checkExpired bool) []TKey {
+	var keys []TKey
+	for _, shard := range s.shards {
+		keys = append(keys, shard.Keys(checkExpired)...)
+	}
+	return keys
+}
+
+// Len returns the number of items across every shard.
+func (s *Sharded[TKey, TValue]) Len(checkExpired bool) int {
+	var length int
+	for _, shard := range s.shards {
+		length += shard.Len(checkExpired)
+	}
+	return length
+}
+
+// Has returns true if the key exists in its shard.
+func (s *Sharded[TKey, TValue]) Has(key TKey) bool {
+	return s.shardFor(key).Has(key)
+}
+
+// Cap returns the sum of every shard's capacity, or 0 if every shard is
+// unbounded (the default unless built with WithCapacity).
+func (s *Sharded[TKey, TValue]) Cap() int {
+	var total int
+	for _, shard := range s.shards {
+		total += shard.Cap()
+	}
+	return total
+}
+
+// Stop terminates the background janitor goroutine on every shard started
+// via WithJanitor. It is a no-op for caches created without that option, and
+// must not be called more than once.
+func (s *Sharded[TKey, TValue]) Stop() {
+	for _, shard := range s.shards {
+		shard.Stop()
+	}
+}
+
+// Metrics returns the sum of every shard's counters, with AvgLoaderLatency
+// recomputed as the weighted average across shards.
+func (s *Sharded[TKey, TValue]) Metrics() Metrics {
+	var agg Metrics
+	var latencySum time.Duration
+	for _, shard := range s.shards {
+		m := shard.Metrics()
+		agg.Hits += m.Hits
+		agg.Misses += m.Misses
+		agg.Insertions += m.Insertions
+		agg.Evictions += m.Evictions
+		agg.LoaderCalls += m.LoaderCalls
+		agg.LoaderErrors += m.LoaderErrors
+		latencySum += m.AvgLoaderLatency * time.Duration(m.LoaderCalls)
+	}
+	if agg.LoaderCalls > 0 {
+		agg.AvgLoaderLatency = latencySum / time.Duration(agg.LoaderCalls)
+	}
+	return agg
+}
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+var shardCounts = []int{1, 16, 64, 256}
+
+// BenchmarkSetParallel measures concurrent Set throughput across shard counts.
+func BenchmarkSetParallel(b *testing.B) {
+	for _, shards := range shardCounts {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			cc := NewSharded[int, int](time.Minute, shards, HashInt)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					cc.Set(i, i)
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkGetParallel measures concurrent Get throughput across shard counts.
+func BenchmarkGetParallel(b *testing.B) {
+	const keys = 1000
+	for _, shards := range shardCounts {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			cc := NewSharded[int, int](time.Minute, shards, HashInt)
+			for i := 0; i < keys; i++ {
+				cc.Set(i, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					_, _ = cc.Get(i % keys)
+					i++
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkUpdateParallel measures concurrent Update throughput across shard counts.
+func BenchmarkUpdateParallel(b *testing.B) {
+	const keys = 1000
+	calc := func(v int) int { return v + 1 }
+	for _, shards := range shardCounts {
+		b.Run(fmt.Sprintf("shards=%d", shards), func(b *testing.B) {
+			cc := NewSharded[int, int](time.Minute, shards, HashInt)
+			for i := 0; i < keys; i++ {
+				cc.Set(i, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					cc.Update(i%keys, calc)
+					i++
+				}
+			})
+		})
+	}
+}
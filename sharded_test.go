@@ -0,0 +1,152 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+)
+
+type ShardedSuite struct {
+	suite.Suite
+}
+
+func TestShardedSuite(t *testing.T) {
+	suite.Run(t, &ShardedSuite{})
+}
+
+// TestShardedSetGet ensures values route to a shard and come back via Get
+// regardless of how many shards are configured.
+func (s *ShardedSuite) TestShardedSetGet() {
+	var (
+		validate = s.Assert()
+		cc       = NewSharded[string, int](time.Minute, 8, HashString)
+	)
+
+	for i := 0; i < 26; i++ {
+		cc.Set(string(rune('a'+i)), i)
+	}
+
+	v, err := cc.Get("a")
+	validate.NoError(err)
+	validate.Equal(0, v)
+
+	validate.True(cc.Has("a"))
+	validate.False(cc.Has("not-there"))
+}
+
+// TestShardedAggregation ensures Keys/Len/Purge/Metrics aggregate across shards.
+func (s *ShardedSuite) TestShardedAggregation() {
+	var (
+		validate = s.Assert()
+		cc       = NewSharded[int, int](time.Minute, 4, HashInt)
+	)
+
+	for i := 0; i < 20; i++ {
+		cc.Set(i, i)
+	}
+	validate.Equal(20, cc.Len(false))
+	validate.Len(cc.Keys(false), 20)
+	validate.Equal(uint64(20), cc.Metrics().Insertions)
+
+	cc.Purge()
+	validate.Equal(0, cc.Len(false))
+	validate.Empty(cc.Keys(false))
+}
+
+// TestShardedUpdate ensures Update is routed to the correct shard and is atomic per key.
+func (s *ShardedSuite) TestShardedUpdate() {
+	var (
+		validate = s.Assert()
+		cc       = NewSharded[int, int](time.Minute, 4, HashInt)
+	)
+
+	cc.Set(1, 1)
+	cc.Update(1, func(v int) int { return v + 41 })
+
+	v, err := cc.Get(1)
+	validate.NoError(err)
+	validate.Equal(42, v)
+}
+
+// TestShardedSingleShard ensures a shard count below 1 is treated as 1.
+func (s *ShardedSuite) TestShardedSingleShard() {
+	cc := NewSharded[int, int](time.Minute, 0, HashInt)
+	cc.Set(1, 1)
+	v, err := cc.Get(1)
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, v)
+}
+
+// TestShardedWithOptionsLoaderFunc ensures NewShardedWithOptions wires a
+// loader into every shard, and that each shard's own Group singleflights
+// concurrent misses for keys routed to it.
+func (s *ShardedSuite) TestShardedWithOptionsLoaderFunc() {
+	var (
+		validate = s.Assert()
+		calls    atomic.Uint64
+	)
+
+	cc := NewShardedWithOptions[int, int](4, HashInt, WithTTL[int, int](time.Minute),
+		WithLoaderFunc[int, int](func(key int) (int, error) {
+			calls.Add(1)
+			return key * 2, nil
+		}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cc.Get(1)
+			validate.NoError(err)
+			validate.Equal(2, v)
+		}()
+	}
+	wg.Wait()
+
+	validate.Equal(uint64(1), calls.Load())
+
+	v, err := cc.Get(5)
+	validate.NoError(err)
+	validate.Equal(10, v)
+}
+
+// TestShardedCap ensures Cap reports the sum of every shard's configured
+// capacity, and 0 for shards built without WithCapacity.
+func (s *ShardedSuite) TestShardedCap() {
+	var (
+		validate = s.Assert()
+		bounded  = NewShardedWithOptions[int, int](4, HashInt, WithCapacity[int, int](10))
+		open     = NewSharded[int, int](time.Minute, 4, HashInt)
+	)
+
+	validate.Equal(40, bounded.Cap())
+	validate.Equal(0, open.Cap())
+}
+
+// TestShardedWithOptionsJanitorStop ensures WithJanitor starts a janitor
+// goroutine on every shard reachable through NewShardedWithOptions, and
+// that Stop terminates all of them without leaking.
+func (s *ShardedSuite) TestShardedWithOptionsJanitorStop() {
+	defer goleak.VerifyNone(s.T())
+
+	var (
+		validate = s.Assert()
+		cc       = NewShardedWithOptions[int, int](4, HashInt,
+			WithTTL[int, int](50*time.Millisecond), WithJanitor[int, int]())
+	)
+
+	for i := 0; i < 20; i++ {
+		cc.Set(i, i)
+	}
+
+	validate.Eventually(func() bool {
+		return cc.Len(false) == 0
+	}, time.Second, 10*time.Millisecond, "janitor did not actively expire items on every shard")
+
+	cc.Stop()
+}
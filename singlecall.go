@@ -12,16 +12,82 @@ package cache
 // https://github.com/bluele/gcache/blob/master/singleflight.go
 
 import (
+	"context"
 	"sync"
+	"time"
 )
 
-// call is an in-flight or completed Do call
+// call is an in-flight or completed Do call. A cancelled waiter does not
+// abort the call: cancelCh only closes once live drops to zero, i.e. once
+// every waiter registered via joinWaiter has cancelled, so the loader keeps
+// running as long as at least one caller still wants the result (see
+// callContext). live and the cancelCh close are guarded by mu rather than
+// done atomically, so a waiter joining and the last remaining waiter
+// cancelling can never race into a double close: whichever happens first
+// is fully applied before the other observes live. A waiter that joins
+// strictly after cancelCh has already closed does not reopen it - the
+// loader was already told no one wanted the result.
 type call[TValue any] struct {
-	wg  sync.WaitGroup
-	val TValue
-	err error
+	mu        sync.Mutex
+	live      int // waiters that have not yet cancelled or been served
+	cancelled bool
+	firstCtx  context.Context
+	cancelCh  chan struct{}
+	done      chan struct{}
+	val       TValue
+	err       error
 }
 
+func newCall[TValue any](ctx context.Context) *call[TValue] {
+	return &call[TValue]{
+		live:     1,
+		firstCtx: ctx,
+		cancelCh: make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// joinWaiter counts one more waiter wanting the in-flight call's result.
+func (c *call[TValue]) joinWaiter() {
+	c.mu.Lock()
+	c.live++
+	c.mu.Unlock()
+}
+
+// leaveWaiter un-registers a waiter that gave up because its ctx was
+// cancelled, closing cancelCh once it was the last one.
+func (c *call[TValue]) leaveWaiter() {
+	c.mu.Lock()
+	c.live--
+	if c.live == 0 && !c.cancelled {
+		c.cancelled = true
+		close(c.cancelCh)
+	}
+	c.mu.Unlock()
+}
+
+// callContext is passed to the loader function instead of any single
+// waiter's context: it is cancelled only once cancelCh closes, i.e. once
+// every waiter has cancelled, so an early waiter giving up does not abort
+// the call for the others. Value lookups fall back to the first waiter's
+// context.
+type callContext struct {
+	parent context.Context
+	done   <-chan struct{}
+}
+
+func (c *callContext) Deadline() (deadline time.Time, ok bool) { return time.Time{}, false }
+func (c *callContext) Done() <-chan struct{}                   { return c.done }
+func (c *callContext) Err() error {
+	select {
+	case <-c.done:
+		return context.Canceled
+	default:
+		return nil
+	}
+}
+func (c *callContext) Value(key any) any { return c.parent.Value(key) }
+
 // Group represents a class of work and forms a namespace in which
 // units of work can be executed with duplicate suppression.
 type Group[TKey comparable, TValue any] struct {
@@ -33,8 +99,10 @@ type Group[TKey comparable, TValue any] struct {
 // Do executes and returns the results of the given function, making
 // sure that only one execution is in-flight for a given key at a
 // time. If a duplicate comes in, the duplicate caller waits for the
-// original to complete and receives the same results.
-func (g *Group[TKey, TValue]) Do(key TKey, fn func() (TValue, error), isWait bool) (res TValue, dupl bool, err error) {
+// original to complete and receives the same results. ctx cancelling while
+// other callers are still waiting does not abort the in-flight call; see
+// callContext.
+func (g *Group[TKey, TValue]) Do(ctx context.Context, key TKey, fn func(context.Context) (TValue, error), isWait bool) (res TValue, dupl bool, err error) {
 	var def TValue
 	g.mtx.Lock()
 	v, err := g.c.get(key)
@@ -46,28 +114,39 @@ func (g *Group[TKey, TValue]) Do(key TKey, fn func() (TValue, error), isWait boo
 		g.m = make(map[TKey]*call[TValue])
 	}
 	if c, ok := g.m[key]; ok {
-		g.mtx.Unlock()
 		if !isWait {
+			g.mtx.Unlock()
 			return def, false, ErrNotFound
 		}
-		c.wg.Wait()
-		return c.val, false, c.err
+		c.joinWaiter()
+		g.mtx.Unlock()
+		select {
+		case <-c.done:
+			return c.val, false, c.err
+		case <-ctx.Done():
+			c.leaveWaiter()
+			return def, false, ctx.Err()
+		}
 	}
-	c := new(call[TValue])
-	c.wg.Add(1)
+	c := newCall[TValue](ctx)
 	g.m[key] = c
 	g.mtx.Unlock()
+	go func() { _, _ = g.call(c, key, fn) }()
 	if !isWait {
-		go func() { _, _ = g.call(c, key, fn) }()
 		return def, false, ErrNotFound
 	}
-	v, err = g.call(c, key, fn)
-	return v, true, err
+	select {
+	case <-c.done:
+		return c.val, true, c.err
+	case <-ctx.Done():
+		c.leaveWaiter()
+		return def, true, ctx.Err()
+	}
 }
 
-func (g *Group[TKey, TValue]) call(c *call[TValue], key TKey, fn func() (TValue, error)) (TValue, error) {
-	c.val, c.err = fn()
-	c.wg.Done()
+func (g *Group[TKey, TValue]) call(c *call[TValue], key TKey, fn func(context.Context) (TValue, error)) (TValue, error) {
+	c.val, c.err = fn(&callContext{parent: c.firstCtx, done: c.cancelCh})
+	close(c.done)
 
 	g.mtx.Lock()
 	delete(g.m, key)